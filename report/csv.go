@@ -0,0 +1,39 @@
+package report
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// CSV writes results as a CSV table mirroring the text schedule table's columns, with an extra
+// leading Algorithm column so rows from different algorithms can be told apart after piping
+// multiple results into one file.
+func CSV(w io.Writer, results []NamedResult) error {
+	cw := csv.NewWriter(w)
+	header := []string{"Algorithm", "ID", "Priority", "Burst", "Arrival", "Wait", "Turnaround", "Exit"}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		for _, m := range r.Result.Metrics {
+			row := []string{
+				r.Name,
+				fmt.Sprint(m.ProcessID),
+				fmt.Sprint(m.Priority),
+				fmt.Sprint(m.BurstDuration),
+				fmt.Sprint(m.ArrivalTime),
+				fmt.Sprint(m.Wait),
+				fmt.Sprint(m.Turnaround),
+				fmt.Sprint(m.Completion),
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,43 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/tb7512/4600-Project-1/report"
+)
+
+// TestCSVHasAlgorithmColumnAndOneRowPerProcess checks that CSV writes the usual schedule-table
+// columns with an extra leading Algorithm column, and one row per process per algorithm.
+func TestCSVHasAlgorithmColumnAndOneRowPerProcess(t *testing.T) {
+	var buf bytes.Buffer
+	if err := report.CSV(&buf, fixtureResults()); err != nil {
+		t.Fatalf("CSV() error = %v", err)
+	}
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("csv.ReadAll() error = %v", err)
+	}
+
+	wantHeader := []string{"Algorithm", "ID", "Priority", "Burst", "Arrival", "Wait", "Turnaround", "Exit"}
+	if len(rows) == 0 {
+		t.Fatal("CSV() wrote no rows")
+	}
+	for i, col := range wantHeader {
+		if rows[0][i] != col {
+			t.Errorf("header[%d] = %q, want %q", i, rows[0][i], col)
+		}
+	}
+
+	if len(rows) != 3 { // header + 2 processes
+		t.Fatalf("len(rows) = %d, want 3", len(rows))
+	}
+	if rows[1][0] != "First-come, first-serve" {
+		t.Errorf("rows[1][0] = %q, want %q", rows[1][0], "First-come, first-serve")
+	}
+	if rows[2][1] != "2" || rows[2][7] != "8" {
+		t.Errorf("rows[2] = %v, want ID 2, Exit 8", rows[2])
+	}
+}
@@ -0,0 +1,55 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type jsonProcess struct {
+	ProcessID     int64 `json:"process_id"`
+	Priority      int64 `json:"priority"`
+	BurstDuration int64 `json:"burst_duration"`
+	ArrivalTime   int64 `json:"arrival_time"`
+	Wait          int64 `json:"wait"`
+	Turnaround    int64 `json:"turnaround"`
+	Completion    int64 `json:"completion"`
+}
+
+type jsonResult struct {
+	Algorithm         string        `json:"algorithm"`
+	Processes         []jsonProcess `json:"processes"`
+	AverageWait       float64       `json:"average_wait"`
+	AverageTurnaround float64       `json:"average_turnaround"`
+	Throughput        float64       `json:"throughput"`
+}
+
+// JSON writes results as a JSON array, one object per algorithm, each with its per-process
+// metrics and aggregate averages.
+func JSON(w io.Writer, results []NamedResult) error {
+	docs := make([]jsonResult, len(results))
+	for i, r := range results {
+		processes := make([]jsonProcess, len(r.Result.Metrics))
+		for j, m := range r.Result.Metrics {
+			processes[j] = jsonProcess{
+				ProcessID:     m.ProcessID,
+				Priority:      m.Priority,
+				BurstDuration: m.BurstDuration,
+				ArrivalTime:   m.ArrivalTime,
+				Wait:          m.Wait,
+				Turnaround:    m.Turnaround,
+				Completion:    m.Completion,
+			}
+		}
+		docs[i] = jsonResult{
+			Algorithm:         r.Name,
+			Processes:         processes,
+			AverageWait:       r.Result.AverageWait,
+			AverageTurnaround: r.Result.AverageTurnaround,
+			Throughput:        r.Result.Throughput,
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(docs)
+}
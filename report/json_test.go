@@ -0,0 +1,71 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/tb7512/4600-Project-1/report"
+	"github.com/tb7512/4600-Project-1/scheduler"
+)
+
+func fixtureResults() []report.NamedResult {
+	return []report.NamedResult{
+		{
+			Name: "First-come, first-serve",
+			Result: scheduler.Result{
+				Gantt: []scheduler.TimeSlice{
+					{PID: 1, Start: 0, Stop: 5},
+					{PID: 2, Start: 5, Stop: 8},
+				},
+				Metrics: []scheduler.ProcessMetrics{
+					{ProcessID: 1, Priority: 1, BurstDuration: 5, ArrivalTime: 0, Wait: 0, Turnaround: 5, Completion: 5},
+					{ProcessID: 2, Priority: 2, BurstDuration: 3, ArrivalTime: 0, Wait: 5, Turnaround: 8, Completion: 8},
+				},
+				AverageWait:       2.5,
+				AverageTurnaround: 6.5,
+				Throughput:        0.25,
+			},
+		},
+	}
+}
+
+// TestJSONRoundTrips checks that JSON emits one document per algorithm, carrying its name, its
+// per-process metrics, and its aggregate averages through unmodified.
+func TestJSONRoundTrips(t *testing.T) {
+	var buf bytes.Buffer
+	if err := report.JSON(&buf, fixtureResults()); err != nil {
+		t.Fatalf("JSON() error = %v", err)
+	}
+
+	var docs []struct {
+		Algorithm string `json:"algorithm"`
+		Processes []struct {
+			ProcessID  int64 `json:"process_id"`
+			Completion int64 `json:"completion"`
+		} `json:"processes"`
+		AverageWait       float64 `json:"average_wait"`
+		AverageTurnaround float64 `json:"average_turnaround"`
+		Throughput        float64 `json:"throughput"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &docs); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v, output:\n%s", err, buf.String())
+	}
+
+	if len(docs) != 1 {
+		t.Fatalf("len(docs) = %d, want 1", len(docs))
+	}
+	doc := docs[0]
+	if doc.Algorithm != "First-come, first-serve" {
+		t.Errorf("Algorithm = %q, want %q", doc.Algorithm, "First-come, first-serve")
+	}
+	if len(doc.Processes) != 2 {
+		t.Fatalf("len(Processes) = %d, want 2", len(doc.Processes))
+	}
+	if doc.Processes[1].ProcessID != 2 || doc.Processes[1].Completion != 8 {
+		t.Errorf("Processes[1] = %+v, want ProcessID 2, Completion 8", doc.Processes[1])
+	}
+	if doc.AverageWait != 2.5 || doc.AverageTurnaround != 6.5 || doc.Throughput != 0.25 {
+		t.Errorf("averages = (%v, %v, %v), want (2.5, 6.5, 0.25)", doc.AverageWait, doc.AverageTurnaround, doc.Throughput)
+	}
+}
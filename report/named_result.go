@@ -0,0 +1,10 @@
+package report
+
+import "github.com/tb7512/4600-Project-1/scheduler"
+
+// NamedResult pairs a scheduler.Result with the name of the algorithm that produced it, so the
+// batch formats (JSON, CSV, trace) can tell multiple algorithm runs apart.
+type NamedResult struct {
+	Name   string
+	Result scheduler.Result
+}
@@ -0,0 +1,73 @@
+// Package report renders a scheduler.Result as human-readable text: a title banner, a Gantt
+// chart, and a schedule table with its averages.
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/tb7512/4600-Project-1/scheduler"
+)
+
+// Report writes the full text report for a completed schedule: the title banner, the Gantt
+// chart, and the schedule table with its averages, in that order.
+func Report(w io.Writer, title string, res scheduler.Result) {
+	outputTitle(w, title)
+	outputGantt(w, res.Gantt)
+	outputSchedule(w, rowsFromMetrics(res.Metrics), res.AverageWait, res.AverageTurnaround, res.Throughput)
+}
+
+func rowsFromMetrics(metrics []scheduler.ProcessMetrics) [][]string {
+	rows := make([][]string, len(metrics))
+	for i, m := range metrics {
+		rows[i] = []string{
+			fmt.Sprint(m.ProcessID),
+			fmt.Sprint(m.Priority),
+			fmt.Sprint(m.BurstDuration),
+			fmt.Sprint(m.ArrivalTime),
+			fmt.Sprint(m.Wait),
+			fmt.Sprint(m.Turnaround),
+			fmt.Sprint(m.Completion),
+		}
+	}
+	return rows
+}
+
+func outputTitle(w io.Writer, title string) {
+	_, _ = fmt.Fprintln(w, strings.Repeat("-", len(title)*2))
+	_, _ = fmt.Fprintln(w, strings.Repeat(" ", len(title)/2), title)
+	_, _ = fmt.Fprintln(w, strings.Repeat("-", len(title)*2))
+}
+
+func outputGantt(w io.Writer, gantt []scheduler.TimeSlice) {
+	_, _ = fmt.Fprintln(w, "Gantt schedule")
+	_, _ = fmt.Fprint(w, "|")
+	for i := range gantt {
+		pid := fmt.Sprint(gantt[i].PID)
+		padding := strings.Repeat(" ", (8-len(pid))/2)
+		_, _ = fmt.Fprint(w, padding, pid, padding, "|")
+	}
+	_, _ = fmt.Fprintln(w)
+	for i := range gantt {
+		_, _ = fmt.Fprint(w, fmt.Sprint(gantt[i].Start), "\t")
+		if len(gantt)-1 == i {
+			_, _ = fmt.Fprint(w, fmt.Sprint(gantt[i].Stop))
+		}
+	}
+	_, _ = fmt.Fprintf(w, "\n\n")
+}
+
+func outputSchedule(w io.Writer, rows [][]string, wait, turnaround, throughput float64) {
+	_, _ = fmt.Fprintln(w, "Schedule table")
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"ID", "Priority", "Burst", "Arrival", "Wait", "Turnaround", "Exit"})
+	table.AppendBulk(rows)
+	table.SetFooter([]string{"", "", "", "",
+		fmt.Sprintf("Average\n%.2f", wait),
+		fmt.Sprintf("Average\n%.2f", turnaround),
+		fmt.Sprintf("Throughput\n%.2f/t", throughput)})
+	table.Render()
+}
@@ -0,0 +1,51 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// traceTimeUnit is how many microseconds one simulated tick represents in the emitted trace, so
+// that durations are large enough to be visible in the Chrome/Perfetto viewer.
+const traceTimeUnit = 1000
+
+type traceEvent struct {
+	Name string            `json:"name"`
+	Ph   string            `json:"ph"`
+	PID  int               `json:"pid"`
+	TID  int64             `json:"tid,omitempty"`
+	TS   int64             `json:"ts"`
+	Dur  int64             `json:"dur,omitempty"`
+	Args map[string]string `json:"args,omitempty"`
+}
+
+// Trace writes results in the Chrome/Perfetto Trace Event JSON format: each algorithm becomes its
+// own process track (named via a metadata event) and each process ID its own thread lane within
+// that track, so the combined schedule can be dropped straight into chrome://tracing or the
+// Perfetto UI.
+func Trace(w io.Writer, results []NamedResult) error {
+	events := make([]traceEvent, 0)
+	for pid, r := range results {
+		events = append(events, traceEvent{
+			Name: "process_name",
+			Ph:   "M",
+			PID:  pid,
+			Args: map[string]string{"name": r.Name},
+		})
+		for _, ts := range r.Result.Gantt {
+			events = append(events, traceEvent{
+				Name: fmt.Sprintf("PID %d", ts.PID),
+				Ph:   "X",
+				PID:  pid,
+				TID:  ts.PID,
+				TS:   ts.Start * traceTimeUnit,
+				Dur:  (ts.Stop - ts.Start) * traceTimeUnit,
+			})
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(events)
+}
@@ -0,0 +1,49 @@
+package report_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/tb7512/4600-Project-1/report"
+)
+
+// TestTraceEmitsMetadataAndDurationEvents checks that Trace emits one process_name metadata
+// event per algorithm plus one duration event per Gantt slice, with ts/dur scaled by
+// traceTimeUnit so the result is visible in a Chrome/Perfetto viewer.
+func TestTraceEmitsMetadataAndDurationEvents(t *testing.T) {
+	var buf bytes.Buffer
+	if err := report.Trace(&buf, fixtureResults()); err != nil {
+		t.Fatalf("Trace() error = %v", err)
+	}
+
+	var events []struct {
+		Name string `json:"name"`
+		Ph   string `json:"ph"`
+		PID  int    `json:"pid"`
+		TID  int64  `json:"tid"`
+		TS   int64  `json:"ts"`
+		Dur  int64  `json:"dur"`
+		Args struct {
+			Name string `json:"name"`
+		} `json:"args"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &events); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v, output:\n%s", err, buf.String())
+	}
+
+	// One metadata event plus one event per Gantt slice (2, from fixtureResults).
+	if len(events) != 3 {
+		t.Fatalf("len(events) = %d, want 3", len(events))
+	}
+
+	meta := events[0]
+	if meta.Ph != "M" || meta.Args.Name != "First-come, first-serve" {
+		t.Errorf("events[0] = %+v, want a process_name metadata event naming the algorithm", meta)
+	}
+
+	slice := events[1]
+	if slice.Ph != "X" || slice.TID != 1 || slice.TS != 0 || slice.Dur != 5000 {
+		t.Errorf("events[1] = %+v, want PID 1 duration event starting at ts=0 with dur=5000", slice)
+	}
+}
@@ -0,0 +1,52 @@
+package scheduler
+
+// Result holds everything a caller needs to report on a completed schedule: the Gantt chart, the
+// per-process metrics, and the aggregate statistics. It is format-agnostic -- report renders it
+// as text, JSON, CSV, or a Chrome trace.
+type Result struct {
+	Gantt             []TimeSlice
+	Metrics           []ProcessMetrics
+	AverageWait       float64
+	AverageTurnaround float64
+	Throughput        float64
+}
+
+// Algorithm is a scheduling policy that can be run against a set of processes.
+type Algorithm interface {
+	// Name returns the human-readable name of the algorithm, used in reports.
+	Name() string
+	// Schedule runs the algorithm against procs and returns the resulting schedule.
+	Schedule(procs []Process) Result
+}
+
+// Factory constructs a new Algorithm instance. Algorithms are constructed fresh per Schedule call
+// so that an implementation can keep private, mutable scheduling state on itself if it needs to.
+type Factory func() Algorithm
+
+var (
+	registry = map[string]Factory{}
+	order    []string
+)
+
+// Register adds an algorithm factory under name, so it can later be looked up with Get or listed
+// with Names. Implementations register themselves from an init function.
+func Register(name string, factory Factory) {
+	if _, exists := registry[name]; !exists {
+		order = append(order, name)
+	}
+	registry[name] = factory
+}
+
+// Get constructs a new instance of the algorithm registered under name.
+func Get(name string) (Algorithm, bool) {
+	factory, ok := registry[name]
+	if !ok {
+		return nil, false
+	}
+	return factory(), true
+}
+
+// Names returns the names of all registered algorithms, in registration order.
+func Names() []string {
+	return append([]string(nil), order...)
+}
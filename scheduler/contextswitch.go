@@ -0,0 +1,57 @@
+package scheduler
+
+// ContextSwitchCost is the number of ticks of CPU-idle overhead charged whenever the CPU switches
+// from running one PID to a different one. It defaults to 0 (no overhead); main sets it from the
+// --context-switch flag.
+var ContextSwitchCost int64
+
+// ApplyContextSwitch returns res with ContextSwitchCost ticks of idle time inserted every time
+// the Gantt chart moves from one PID to a different one, shifting every later slice and metric to
+// match. Applying the cost this way, after an algorithm has already decided an order, keeps it
+// independent of which algorithm produced res -- every algorithm gets the same overhead without
+// needing to know about it.
+func ApplyContextSwitch(res Result, cost int64) Result {
+	if cost <= 0 || len(res.Gantt) == 0 {
+		return res
+	}
+
+	gantt := make([]TimeSlice, len(res.Gantt))
+	shiftAtCompletion := make(map[int64]int64, len(res.Metrics))
+	var shift int64
+	for i, ts := range res.Gantt {
+		if i > 0 && ts.PID != res.Gantt[i-1].PID {
+			shift += cost
+		}
+		gantt[i] = TimeSlice{PID: ts.PID, Start: ts.Start + shift, Stop: ts.Stop + shift}
+		shiftAtCompletion[ts.PID] = shift
+	}
+
+	metrics := make([]ProcessMetrics, len(res.Metrics))
+	var totalWait, totalTurnaround, lastCompletion float64
+	for i, m := range res.Metrics {
+		s := shiftAtCompletion[m.ProcessID]
+		metrics[i] = ProcessMetrics{
+			ProcessID:     m.ProcessID,
+			Priority:      m.Priority,
+			BurstDuration: m.BurstDuration,
+			ArrivalTime:   m.ArrivalTime,
+			Wait:          m.Wait + s,
+			Turnaround:    m.Turnaround + s,
+			Completion:    m.Completion + s,
+		}
+		totalWait += float64(metrics[i].Wait)
+		totalTurnaround += float64(metrics[i].Turnaround)
+		if c := float64(metrics[i].Completion); c > lastCompletion {
+			lastCompletion = c
+		}
+	}
+
+	count := float64(len(metrics))
+	return Result{
+		Gantt:             gantt,
+		Metrics:           metrics,
+		AverageWait:       totalWait / count,
+		AverageTurnaround: totalTurnaround / count,
+		Throughput:        count / lastCompletion,
+	}
+}
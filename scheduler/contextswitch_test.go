@@ -0,0 +1,53 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/tb7512/4600-Project-1/scheduler"
+)
+
+func TestApplyContextSwitch(t *testing.T) {
+	res := scheduler.Result{
+		Gantt: []scheduler.TimeSlice{
+			{PID: 1, Start: 0, Stop: 5},
+			{PID: 2, Start: 5, Stop: 8},
+		},
+		Metrics: []scheduler.ProcessMetrics{
+			{ProcessID: 1, BurstDuration: 5, ArrivalTime: 0, Wait: 0, Turnaround: 5, Completion: 5},
+			{ProcessID: 2, BurstDuration: 3, ArrivalTime: 0, Wait: 2, Turnaround: 5, Completion: 8},
+		},
+	}
+
+	got := scheduler.ApplyContextSwitch(res, 2)
+
+	wantGantt := []scheduler.TimeSlice{
+		{PID: 1, Start: 0, Stop: 5},
+		{PID: 2, Start: 7, Stop: 10},
+	}
+	for i, ts := range got.Gantt {
+		if ts != wantGantt[i] {
+			t.Errorf("Gantt[%d] = %+v, want %+v", i, ts, wantGantt[i])
+		}
+	}
+
+	if got.Metrics[0].Completion != 5 {
+		t.Errorf("PID 1 completion = %d, want 5 (unaffected by the single switch)", got.Metrics[0].Completion)
+	}
+	if got.Metrics[1].Completion != 10 {
+		t.Errorf("PID 2 completion = %d, want 10 (shifted by the one switch into it)", got.Metrics[1].Completion)
+	}
+	if got.Metrics[1].Wait != 4 {
+		t.Errorf("PID 2 wait = %d, want 4", got.Metrics[1].Wait)
+	}
+}
+
+func TestApplyContextSwitchNoop(t *testing.T) {
+	res := scheduler.Result{
+		Gantt:   []scheduler.TimeSlice{{PID: 1, Start: 0, Stop: 5}},
+		Metrics: []scheduler.ProcessMetrics{{ProcessID: 1, Completion: 5}},
+	}
+	got := scheduler.ApplyContextSwitch(res, 0)
+	if got.Gantt[0] != res.Gantt[0] {
+		t.Errorf("cost=0 should leave the Gantt untouched, got %+v", got.Gantt[0])
+	}
+}
@@ -0,0 +1,114 @@
+package scheduler
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// LoadProcesses reads rows of "pid,burst,arrival[,priority[,ioburst]]" from r into a slice of
+// Process. ioburst, when present, is a colon-separated "cpu:io:cpu:io:...:cpu" sequence describing
+// how the process alternates between running and blocking on I/O; when it is given, BurstDuration
+// is derived from its CPU segments rather than taken from the burst column.
+func LoadProcesses(r io.Reader) ([]Process, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1 // rows may have 3-5 fields: priority and IOBursts are both optional
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: reading CSV", err)
+	}
+
+	processes := make([]Process, len(rows))
+	for i := range rows {
+		processes[i].ProcessID, err = parseField(rows[i], 0)
+		if err != nil {
+			return nil, err
+		}
+		processes[i].BurstDuration, err = parseField(rows[i], 1)
+		if err != nil {
+			return nil, err
+		}
+		processes[i].ArrivalTime, err = parseField(rows[i], 2)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows[i]) >= 4 && rows[i][3] != "" {
+			processes[i].Priority, err = parseField(rows[i], 3)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(rows[i]) >= 5 && rows[i][4] != "" {
+			bursts, err := parseIOBursts(rows[i][4])
+			if err != nil {
+				return nil, err
+			}
+			processes[i].IOBursts = bursts
+			processes[i].BurstDuration = cpuDemand(bursts)
+		}
+	}
+
+	return processes, nil
+}
+
+// WriteProcesses writes processes back out in the same CSV shape LoadProcesses reads, so
+// generated workloads can be saved and later replayed.
+func WriteProcesses(w io.Writer, processes []Process) error {
+	cw := csv.NewWriter(w)
+	for _, p := range processes {
+		row := []string{
+			strconv.FormatInt(p.ProcessID, 10),
+			strconv.FormatInt(p.BurstDuration, 10),
+			strconv.FormatInt(p.ArrivalTime, 10),
+			strconv.FormatInt(p.Priority, 10),
+		}
+		if len(p.IOBursts) > 0 {
+			row = append(row, formatIOBursts(p.IOBursts))
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func parseField(row []string, col int) (int64, error) {
+	i, err := strconv.ParseInt(row[col], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: parsing column %d", err, col)
+	}
+	return i, nil
+}
+
+func parseIOBursts(s string) ([]int64, error) {
+	parts := strings.Split(s, ":")
+	bursts := make([]int64, len(parts))
+	for i, part := range parts {
+		v, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: parsing IO burst segment %d", err, i)
+		}
+		bursts[i] = v
+	}
+	return bursts, nil
+}
+
+func formatIOBursts(bursts []int64) string {
+	parts := make([]string, len(bursts))
+	for i, v := range bursts {
+		parts[i] = strconv.FormatInt(v, 10)
+	}
+	return strings.Join(parts, ":")
+}
+
+// cpuDemand sums the CPU segments (the even-indexed entries) of a cpu:io:cpu:io:... sequence.
+func cpuDemand(bursts []int64) int64 {
+	var sum int64
+	for i := 0; i < len(bursts); i += 2 {
+		sum += bursts[i]
+	}
+	return sum
+}
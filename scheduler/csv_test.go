@@ -0,0 +1,89 @@
+package scheduler_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/tb7512/4600-Project-1/scheduler"
+)
+
+// TestLoadProcessesOptionalColumns checks that LoadProcesses accepts rows with 3 (no priority or
+// IOBursts), 4 (priority only), and 5 (priority and IOBursts) fields in the same file, and that
+// an IOBursts column overrides BurstDuration with the sum of its CPU segments.
+func TestLoadProcessesOptionalColumns(t *testing.T) {
+	r := strings.NewReader("1,5,0\n2,3,1,2\n3,9,2,1,2:3:3:4:1\n")
+
+	processes, err := scheduler.LoadProcesses(r)
+	if err != nil {
+		t.Fatalf("LoadProcesses() error = %v", err)
+	}
+	if len(processes) != 3 {
+		t.Fatalf("len(processes) = %d, want 3", len(processes))
+	}
+
+	p1 := processes[0]
+	if p1.ProcessID != 1 || p1.BurstDuration != 5 || p1.ArrivalTime != 0 || p1.Priority != 0 {
+		t.Errorf("processes[0] = %+v, want ProcessID 1, BurstDuration 5, ArrivalTime 0, Priority 0", p1)
+	}
+
+	p2 := processes[1]
+	if p2.ProcessID != 2 || p2.BurstDuration != 3 || p2.ArrivalTime != 1 || p2.Priority != 2 {
+		t.Errorf("processes[1] = %+v, want ProcessID 2, BurstDuration 3, ArrivalTime 1, Priority 2", p2)
+	}
+
+	p3 := processes[2]
+	wantBursts := []int64{2, 3, 3, 4, 1}
+	if p3.ProcessID != 3 || p3.Priority != 1 || p3.ArrivalTime != 2 {
+		t.Errorf("processes[2] = %+v, want ProcessID 3, Priority 1, ArrivalTime 2", p3)
+	}
+	if len(p3.IOBursts) != len(wantBursts) {
+		t.Fatalf("IOBursts = %v, want %v", p3.IOBursts, wantBursts)
+	}
+	for i, v := range wantBursts {
+		if p3.IOBursts[i] != v {
+			t.Errorf("IOBursts[%d] = %d, want %d", i, p3.IOBursts[i], v)
+		}
+	}
+	// BurstDuration must be derived from the CPU segments (2+3+1 = 6), not the burst column (9).
+	if p3.BurstDuration != 6 {
+		t.Errorf("BurstDuration = %d, want 6 (sum of the CPU segments)", p3.BurstDuration)
+	}
+}
+
+// TestWriteProcessesRoundTrips checks that WriteProcesses followed by LoadProcesses reproduces
+// the original processes, including an IOBursts column.
+func TestWriteProcessesRoundTrips(t *testing.T) {
+	original := []scheduler.Process{
+		{ProcessID: 1, ArrivalTime: 0, BurstDuration: 5, Priority: 3},
+		{ProcessID: 2, ArrivalTime: 1, BurstDuration: 6, Priority: 1, IOBursts: []int64{2, 3, 4}},
+	}
+
+	var buf bytes.Buffer
+	if err := scheduler.WriteProcesses(&buf, original); err != nil {
+		t.Fatalf("WriteProcesses() error = %v", err)
+	}
+
+	roundTripped, err := scheduler.LoadProcesses(&buf)
+	if err != nil {
+		t.Fatalf("LoadProcesses() error = %v", err)
+	}
+	if len(roundTripped) != len(original) {
+		t.Fatalf("len(roundTripped) = %d, want %d", len(roundTripped), len(original))
+	}
+
+	for i, want := range original {
+		got := roundTripped[i]
+		if got.ProcessID != want.ProcessID || got.ArrivalTime != want.ArrivalTime ||
+			got.BurstDuration != want.BurstDuration || got.Priority != want.Priority ||
+			len(got.IOBursts) != len(want.IOBursts) {
+			t.Errorf("roundTripped[%d] = %+v, want %+v", i, got, want)
+			continue
+		}
+		for j, v := range want.IOBursts {
+			if got.IOBursts[j] != v {
+				t.Errorf("roundTripped[%d].IOBursts[%d] = %d, want %d", i, j, got.IOBursts[j], v)
+			}
+		}
+	}
+}
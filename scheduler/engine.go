@@ -0,0 +1,144 @@
+package scheduler
+
+// pickFunc selects, from the set of ready process indices, which one should run next. ready is
+// never empty when pickFunc is called, and current is the index of the process currently
+// holding the CPU, or -1 if the CPU was idle.
+type pickFunc func(ready []int, processes []Process, remaining []int64, current int, time int64) int
+
+// simulate is the shared discrete-event core behind the tick-driven algorithms: it advances time
+// one tick at a time, lets processes block on and return from I/O bursts independently of the
+// CPU, and defers every scheduling decision to pick. preemptive re-invokes pick on every tick a
+// process is running; quantum (if > 0) forces a re-invocation once a process has held the CPU for
+// that many ticks, whichever comes first.
+//
+// Processes with no IOBursts behave exactly as a single CPU segment equal to BurstDuration, so
+// simulate is a drop-in replacement for the plain CPU-only tick loops the older algorithms used.
+func simulate(processes []Process, pick pickFunc, preemptive bool, quantum int64) Result {
+	n := len(processes)
+	segments := make([][]int64, n)
+	segIndex := make([]int, n)
+	remaining := make([]int64, n)
+	blocked := make([]bool, n)
+	arrived := make([]bool, n)
+	finished := make([]bool, n)
+	for i := range processes {
+		segs := processes[i].IOBursts
+		if len(segs) == 0 {
+			segs = []int64{processes[i].BurstDuration}
+		}
+		segments[i] = segs
+		remaining[i] = segs[0]
+	}
+
+	var (
+		time          int64
+		done          int
+		current       = -1
+		sliceStart    int64
+		ranInSlice    int64
+		totalWait     float64
+		totalTurn     float64
+		lastCompleted float64
+		gantt         = make([]TimeSlice, 0)
+		metrics       = make([]ProcessMetrics, n)
+	)
+
+	closeSlice := func() {
+		if current != -1 {
+			gantt = append(gantt, TimeSlice{PID: processes[current].ProcessID, Start: sliceStart, Stop: time})
+		}
+	}
+
+	// advanceIO counts one elapsed tick against every blocked process's I/O burst. It runs at the
+	// end of a tick, before the running process can block, so an I/O burst of N ticks blocks for N.
+	advanceIO := func() {
+		for i := range blocked {
+			if blocked[i] {
+				remaining[i]--
+			}
+		}
+	}
+
+	for done < n {
+		for i := range processes {
+			if !arrived[i] && processes[i].ArrivalTime <= time {
+				arrived[i] = true
+			}
+			if blocked[i] && remaining[i] <= 0 {
+				blocked[i] = false
+				segIndex[i]++
+				remaining[i] = segments[i][segIndex[i]]
+			}
+		}
+
+		ready := make([]int, 0, n)
+		for i := range processes {
+			if arrived[i] && !finished[i] && !blocked[i] {
+				ready = append(ready, i)
+			}
+		}
+
+		if len(ready) == 0 {
+			closeSlice()
+			current = -1
+			time++
+			advanceIO()
+			continue
+		}
+
+		needsPick := current == -1 || !contains(ready, current) || preemptive ||
+			(quantum > 0 && ranInSlice >= quantum)
+		if needsPick {
+			pickIdx := pick(ready, processes, remaining, current, time)
+			if pickIdx != current {
+				closeSlice()
+				current = pickIdx
+				sliceStart = time
+			}
+			ranInSlice = 0
+		}
+
+		remaining[current]--
+		ranInSlice++
+		time++
+		advanceIO()
+
+		if remaining[current] == 0 {
+			segIndex[current]++
+			if segIndex[current] >= len(segments[current]) {
+				finished[current] = true
+				waiting := time - processes[current].ArrivalTime - processes[current].BurstDuration
+				turnaround := time - processes[current].ArrivalTime
+				totalWait += float64(waiting)
+				totalTurn += float64(turnaround)
+				lastCompleted = float64(time)
+				metrics[done] = newMetrics(processes[current], waiting, turnaround, time)
+				closeSlice()
+				done++
+			} else {
+				blocked[current] = true
+				remaining[current] = segments[current][segIndex[current]]
+				closeSlice()
+			}
+			current = -1
+		}
+	}
+
+	count := float64(n)
+	return Result{
+		Gantt:             gantt,
+		Metrics:           metrics,
+		AverageWait:       totalWait / count,
+		AverageTurnaround: totalTurn / count,
+		Throughput:        count / lastCompleted,
+	}
+}
+
+func contains(xs []int, v int) bool {
+	for _, x := range xs {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
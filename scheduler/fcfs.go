@@ -0,0 +1,28 @@
+package scheduler
+
+func init() {
+	Register("fcfs", func() Algorithm { return &fcfsAlgorithm{} })
+}
+
+type fcfsAlgorithm struct{}
+
+func (fcfsAlgorithm) Name() string { return "First-come, first-serve" }
+
+// Schedule runs processes in arrival order with no preemption: a process keeps the CPU until it
+// finishes or blocks on I/O, and other ready processes run while it is blocked.
+func (fcfsAlgorithm) Schedule(processes []Process) Result {
+	return simulate(processes, fcfsPick, false, 0)
+}
+
+// fcfsPick keeps running the current process until it blocks or finishes; it only chooses among
+// ready processes once the CPU is actually free, picking whichever arrived earliest.
+func fcfsPick(ready []int, processes []Process, remaining []int64, current int, time int64) int {
+	best := ready[0]
+	for _, i := range ready[1:] {
+		if processes[i].ArrivalTime < processes[best].ArrivalTime ||
+			(processes[i].ArrivalTime == processes[best].ArrivalTime && i < best) {
+			best = i
+		}
+	}
+	return best
+}
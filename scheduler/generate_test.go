@@ -0,0 +1,42 @@
+package scheduler_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tb7512/4600-Project-1/scheduler"
+)
+
+func TestGenerateWorkloadDeterministic(t *testing.T) {
+	a := scheduler.GenerateWorkload(20, 42)
+	b := scheduler.GenerateWorkload(20, 42)
+	if !reflect.DeepEqual(a, b) {
+		t.Fatal("GenerateWorkload(20, 42) produced different results on two calls")
+	}
+
+	c := scheduler.GenerateWorkload(20, 7)
+	if reflect.DeepEqual(a, c) {
+		t.Fatal("GenerateWorkload with different seeds produced identical workloads")
+	}
+}
+
+func TestGenerateWorkloadShape(t *testing.T) {
+	processes := scheduler.GenerateWorkload(50, 1)
+	if len(processes) != 50 {
+		t.Fatalf("len(processes) = %d, want 50", len(processes))
+	}
+
+	var lastArrival int64
+	for _, p := range processes {
+		if p.ArrivalTime < lastArrival {
+			t.Fatalf("arrivals out of order: PID %d arrives at %d, after %d", p.ProcessID, p.ArrivalTime, lastArrival)
+		}
+		lastArrival = p.ArrivalTime
+		if p.BurstDuration < 1 {
+			t.Errorf("PID %d has non-positive burst %d", p.ProcessID, p.BurstDuration)
+		}
+		if p.Priority < 1 || p.Priority > 10 {
+			t.Errorf("PID %d has out-of-range priority %d", p.ProcessID, p.Priority)
+		}
+	}
+}
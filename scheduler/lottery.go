@@ -0,0 +1,46 @@
+package scheduler
+
+import "math/rand"
+
+func init() {
+	Register("lottery", func() Algorithm { return &lotteryAlgorithm{} })
+}
+
+// LotterySeed seeds the lottery scheduler's ticket draws. It defaults to 1 so a run is
+// reproducible unless the caller overrides it (the CLI's --seed flag does this).
+var LotterySeed int64 = 1
+
+const lotteryTimeQuantum int64 = 2
+
+// lotteryAlgorithm implements lottery scheduling: every process holds a number of tickets
+// (ticketsFor), and each quantum a single winning ticket is drawn from the ready processes'
+// combined pool, so a process's long-run CPU share is proportional to its ticket count.
+type lotteryAlgorithm struct {
+	rng *rand.Rand
+}
+
+func (a *lotteryAlgorithm) Name() string { return "Lottery" }
+
+func (a *lotteryAlgorithm) Schedule(processes []Process) Result {
+	a.rng = rand.New(rand.NewSource(LotterySeed))
+	return simulate(processes, a.pick, false, lotteryTimeQuantum)
+}
+
+// pick draws a ticket uniformly from [0, totalTickets) and runs whichever ready process's
+// cumulative range covers it.
+func (a *lotteryAlgorithm) pick(ready []int, processes []Process, remaining []int64, current int, time int64) int {
+	var total int64
+	for _, i := range ready {
+		total += ticketsFor(processes[i])
+	}
+
+	draw := a.rng.Int63n(total)
+	var cum int64
+	for _, i := range ready {
+		cum += ticketsFor(processes[i])
+		if draw < cum {
+			return i
+		}
+	}
+	return ready[len(ready)-1]
+}
@@ -0,0 +1,27 @@
+package scheduler
+
+// ProcessMetrics is the computed scheduling outcome for a single process: its static CSV fields
+// plus the wait, turnaround, and completion time the algorithm produced for it.
+type ProcessMetrics struct {
+	ProcessID     int64
+	Priority      int64
+	BurstDuration int64
+	ArrivalTime   int64
+	Wait          int64
+	Turnaround    int64
+	Completion    int64
+}
+
+// newMetrics builds the ProcessMetrics for process p given its computed wait, turnaround, and
+// completion time.
+func newMetrics(p Process, wait, turnaround, completion int64) ProcessMetrics {
+	return ProcessMetrics{
+		ProcessID:     p.ProcessID,
+		Priority:      p.Priority,
+		BurstDuration: p.BurstDuration,
+		ArrivalTime:   p.ArrivalTime,
+		Wait:          wait,
+		Turnaround:    turnaround,
+		Completion:    completion,
+	}
+}
@@ -0,0 +1,119 @@
+package scheduler
+
+import "sort"
+
+func init() {
+	Register("mlfq", func() Algorithm { return &mlfqAlgorithm{} })
+}
+
+// mlfqLevels configures the multilevel feedback queue: each entry is the time quantum (in ticks)
+// given to a process running at that queue level before it is demoted to the next one.
+var mlfqLevels = []int64{4, 8, 16}
+
+// mlfqBoostInterval is how often (in ticks) every process waiting in a queue is boosted back to
+// queue 0, so a process stuck behind long-running jobs in a low queue can't starve forever. The
+// running process and processes blocked on I/O keep their level.
+const mlfqBoostInterval = 40
+
+// mlfqAlgorithm implements a multilevel feedback queue on top of the shared simulate() core, so
+// it alternates between CPU and blocked states on IOBursts exactly like the other algorithms. A
+// process keeps the queue level it last held across an I/O block; only its first-ever arrival
+// starts it at level 0.
+type mlfqAlgorithm struct {
+	level        []int
+	everSeen     []bool
+	queued       []bool
+	queues       [][]int
+	ticksInSlice int64
+}
+
+func (mlfqAlgorithm) Name() string { return "Multilevel feedback queue" }
+
+// Schedule runs processes through the queue levels and quantums configured in mlfqLevels: a
+// process exhausting its quantum is demoted one level, a process preempted by one arriving (or
+// returning from I/O) into a higher-priority queue keeps its level, and every mlfqBoostInterval
+// ticks every queued process is boosted back to queue 0.
+func (a *mlfqAlgorithm) Schedule(processes []Process) Result {
+	n := len(processes)
+	a.level = make([]int, n)
+	a.everSeen = make([]bool, n)
+	a.queued = make([]bool, n)
+	a.queues = make([][]int, len(mlfqLevels))
+	a.ticksInSlice = 0
+	return simulate(processes, a.pick, true, 0)
+}
+
+// pick enqueues any process that has become ready since the last call (in arrival order, since
+// pick isn't invoked before every arrival lands), applies the periodic boost, and then either
+// keeps the running process going or demotes and requeues it in favor of whoever the queue
+// levels say should run next.
+func (a *mlfqAlgorithm) pick(ready []int, processes []Process, remaining []int64, current int, time int64) int {
+	newlyReady := make([]int, 0, len(ready))
+	for _, i := range ready {
+		if i != current && !a.queued[i] {
+			newlyReady = append(newlyReady, i)
+		}
+	}
+	sort.Slice(newlyReady, func(x, y int) bool {
+		pi, pj := newlyReady[x], newlyReady[y]
+		if processes[pi].ArrivalTime != processes[pj].ArrivalTime {
+			return processes[pi].ArrivalTime < processes[pj].ArrivalTime
+		}
+		return pi < pj
+	})
+	for _, i := range newlyReady {
+		if !a.everSeen[i] {
+			a.level[i] = 0
+			a.everSeen[i] = true
+		}
+		a.queues[a.level[i]] = append(a.queues[a.level[i]], i)
+		a.queued[i] = true
+	}
+
+	if mlfqBoostInterval > 0 && time > 0 && time%mlfqBoostInterval == 0 {
+		for lvl := 1; lvl < len(a.queues); lvl++ {
+			for _, i := range a.queues[lvl] {
+				a.level[i] = 0
+				a.queues[0] = append(a.queues[0], i)
+			}
+			a.queues[lvl] = a.queues[lvl][:0]
+		}
+	}
+
+	if current != -1 {
+		a.ticksInSlice++
+		quantumExpired := a.ticksInSlice >= mlfqLevels[a.level[current]]
+		if topNonEmptyLevel(a.queues) >= a.level[current] && !quantumExpired {
+			return current
+		}
+
+		if quantumExpired && a.level[current] < len(mlfqLevels)-1 {
+			a.level[current]++
+		}
+		a.queues[a.level[current]] = append(a.queues[a.level[current]], current)
+		a.queued[current] = true
+	}
+
+	next := popFront(a.queues, topNonEmptyLevel(a.queues))
+	a.queued[next] = false
+	a.ticksInSlice = 0
+	return next
+}
+
+// topNonEmptyLevel returns the index of the highest-priority (lowest index) non-empty queue, or
+// len(queues) if every queue is empty.
+func topNonEmptyLevel(queues [][]int) int {
+	for lvl := range queues {
+		if len(queues[lvl]) > 0 {
+			return lvl
+		}
+	}
+	return len(queues)
+}
+
+// popFront removes and returns the process index at the front of queues[lvl].
+func popFront(queues [][]int, lvl int) int {
+	i := queues[lvl][0]
+	queues[lvl] = queues[lvl][1:]
+	return i
+}
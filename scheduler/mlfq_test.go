@@ -0,0 +1,57 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/tb7512/4600-Project-1/scheduler"
+)
+
+// TestMLFQIdlesBeforeFirstArrival guards against a panic where, if no process has arrived yet,
+// mlfq tried to pop from an empty queue level instead of idling the CPU until the first arrival.
+func TestMLFQIdlesBeforeFirstArrival(t *testing.T) {
+	processes := []scheduler.Process{
+		{ProcessID: 1, ArrivalTime: 3, BurstDuration: 4},
+		{ProcessID: 2, ArrivalTime: 5, BurstDuration: 2},
+	}
+
+	algo, ok := scheduler.Get("mlfq")
+	if !ok {
+		t.Fatal(`scheduler.Get("mlfq") = false, want true`)
+	}
+
+	res := algo.Schedule(processes)
+	if len(res.Metrics) != len(processes) {
+		t.Fatalf("len(Metrics) = %d, want %d", len(res.Metrics), len(processes))
+	}
+}
+
+// TestMLFQKeepsLevelAcrossIOBlock checks that a process returning from an I/O block resumes at
+// the queue level it had demoted to, rather than always being reset to level 0.
+func TestMLFQKeepsLevelAcrossIOBlock(t *testing.T) {
+	processes := []scheduler.Process{
+		// Runs long enough (burst 20, single CPU segment then a short I/O block, then more CPU)
+		// to demote below level 0 before it blocks.
+		{ProcessID: 1, ArrivalTime: 0, IOBursts: []int64{12, 2, 8}, BurstDuration: 20},
+		// Arrives at level 0 while PID 1 is blocked, and is still mid-quantum when PID 1 returns.
+		{ProcessID: 2, ArrivalTime: 12, BurstDuration: 6},
+	}
+
+	algo, ok := scheduler.Get("mlfq")
+	if !ok {
+		t.Fatal(`scheduler.Get("mlfq") = false, want true`)
+	}
+
+	res := algo.Schedule(processes)
+	if len(res.Gantt) < 2 {
+		t.Fatalf("expected at least 2 Gantt slices (pre- and post-I/O), got %+v", res.Gantt)
+	}
+
+	// PID 1 returns at level 1, waits for PID 2's level-0 quantum to end at 16, then runs its
+	// last 8 ticks in one level-1 quantum: done at 24. Reset to level 0 instead, its 4-tick
+	// quantum would expire at 20, PID 2 would finish first, and PID 1 would complete at 26.
+	for _, m := range res.Metrics {
+		if m.ProcessID == 1 && m.Completion != 24 {
+			t.Errorf("PID 1 completed at %d, want 24 (resumed at level 1 after its I/O block)", m.Completion)
+		}
+	}
+}
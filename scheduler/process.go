@@ -0,0 +1,20 @@
+package scheduler
+
+// Process represents a single process to be scheduled, as read from the input CSV.
+//
+// BurstDuration is the process's total CPU demand. If IOBursts is set, BurstDuration equals the
+// sum of IOBursts' CPU segments rather than a single uninterrupted run.
+type Process struct {
+	ProcessID     int64
+	ArrivalTime   int64
+	BurstDuration int64
+	Priority      int64
+	IOBursts      []int64
+}
+
+// TimeSlice is a single contiguous span during which PID owned the CPU.
+type TimeSlice struct {
+	PID   int64
+	Start int64
+	Stop  int64
+}
@@ -0,0 +1,67 @@
+package scheduler
+
+import "sort"
+
+func init() {
+	Register("rr", func() Algorithm { return &rrAlgorithm{} })
+}
+
+const rrTimeQuantum int64 = 2
+
+// rrAlgorithm implements round-robin over a real FIFO ready queue: a process that arrives (or
+// returns from I/O) is appended to the back of the queue, and a process whose quantum expires
+// without finishing is appended to the back as well, so the process that has waited longest
+// always runs next -- not just whichever has the lowest index.
+type rrAlgorithm struct {
+	queue    []int
+	enqueued []bool
+}
+
+func (a *rrAlgorithm) Name() string { return "Round-robin" }
+
+// Schedule runs processes in a round-robin rotation, preempting whichever process is running
+// once it has used up rrTimeQuantum ticks (and, separately, whenever it blocks on I/O).
+func (a *rrAlgorithm) Schedule(processes []Process) Result {
+	a.queue = nil
+	a.enqueued = make([]bool, len(processes))
+	return simulate(processes, a.pick, false, rrTimeQuantum)
+}
+
+// pick enqueues any process that has become ready since the last pick call -- in arrival order,
+// since pick isn't invoked on every tick -- then, if the process that just ran is still ready
+// (its quantum expired rather than it blocking or finishing), enqueues it behind them. It then
+// returns whoever is at the front of the queue.
+func (a *rrAlgorithm) pick(ready []int, processes []Process, remaining []int64, current int, time int64) int {
+	newlyReady := make([]int, 0, len(ready))
+	for _, i := range ready {
+		if i != current && !a.enqueued[i] {
+			newlyReady = append(newlyReady, i)
+		}
+	}
+	sort.Slice(newlyReady, func(x, y int) bool {
+		pi, pj := newlyReady[x], newlyReady[y]
+		if processes[pi].ArrivalTime != processes[pj].ArrivalTime {
+			return processes[pi].ArrivalTime < processes[pj].ArrivalTime
+		}
+		return pi < pj
+	})
+	for _, i := range newlyReady {
+		a.queue = append(a.queue, i)
+		a.enqueued[i] = true
+	}
+
+	if current != -1 && contains(ready, current) {
+		a.queue = append(a.queue, current)
+		a.enqueued[current] = true
+	}
+
+	for len(a.queue) > 0 {
+		next := a.queue[0]
+		a.queue = a.queue[1:]
+		a.enqueued[next] = false
+		if contains(ready, next) {
+			return next
+		}
+	}
+	return ready[0] // unreachable: pick is only called when ready is non-empty
+}
@@ -0,0 +1,36 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/tb7512/4600-Project-1/scheduler"
+)
+
+// TestRRUsesFIFOOrder guards against a regression where rr rotated through ready processes by
+// "lowest index greater than current, else wrap to the lowest index" rather than a real FIFO
+// queue -- which let a low-index process cut back in line ahead of one that had waited longer.
+func TestRRUsesFIFOOrder(t *testing.T) {
+	processes := []scheduler.Process{
+		{ProcessID: 1, ArrivalTime: 0, BurstDuration: 5, Priority: 2},
+		{ProcessID: 2, ArrivalTime: 1, BurstDuration: 3, Priority: 1},
+		{ProcessID: 3, ArrivalTime: 2, BurstDuration: 8, Priority: 3},
+	}
+
+	algo, ok := scheduler.Get("rr")
+	if !ok {
+		t.Fatal(`scheduler.Get("rr") = false, want true`)
+	}
+
+	res := algo.Schedule(processes)
+	completions := make(map[int64]int64)
+	for _, m := range res.Metrics {
+		completions[m.ProcessID] = m.Completion
+	}
+
+	want := map[int64]int64{1: 12, 2: 9, 3: 16}
+	for pid, wantCompletion := range want {
+		if got := completions[pid]; got != wantCompletion {
+			t.Errorf("PID %d completed at %d, want %d", pid, got, wantCompletion)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/tb7512/4600-Project-1/scheduler"
+)
+
+func fixtureProcesses() []scheduler.Process {
+	return []scheduler.Process{
+		{ProcessID: 1, ArrivalTime: 0, BurstDuration: 5, Priority: 2},
+		{ProcessID: 2, ArrivalTime: 1, BurstDuration: 3, Priority: 1},
+		{ProcessID: 3, ArrivalTime: 2, BurstDuration: 8, Priority: 3},
+	}
+}
+
+// TestAlgorithmsConserveBurstTime checks, for every registered algorithm, that the sum of Gantt
+// slice durations for each PID equals that process's total CPU burst and that no two slices
+// overlap in time -- invariants that must hold regardless of scheduling policy.
+func TestAlgorithmsConserveBurstTime(t *testing.T) {
+	processes := fixtureProcesses()
+	burstByPID := make(map[int64]int64, len(processes))
+	for _, p := range processes {
+		burstByPID[p.ProcessID] = p.BurstDuration
+	}
+
+	for _, name := range scheduler.Names() {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			algo, ok := scheduler.Get(name)
+			if !ok {
+				t.Fatalf("scheduler.Get(%q) = false, want true", name)
+			}
+
+			res := algo.Schedule(processes)
+			if len(res.Metrics) != len(processes) {
+				t.Fatalf("len(Metrics) = %d, want %d", len(res.Metrics), len(processes))
+			}
+
+			durations := make(map[int64]int64)
+			for i, ts := range res.Gantt {
+				if ts.Stop <= ts.Start {
+					t.Fatalf("slice %d for PID %d has non-positive duration: %+v", i, ts.PID, ts)
+				}
+				if i > 0 && ts.Start < res.Gantt[i-1].Stop {
+					t.Fatalf("slice %d for PID %d starts at %d before previous slice ends at %d",
+						i, ts.PID, ts.Start, res.Gantt[i-1].Stop)
+				}
+				durations[ts.PID] += ts.Stop - ts.Start
+			}
+
+			for pid, want := range burstByPID {
+				if got := durations[pid]; got != want {
+					t.Errorf("PID %d ran for %d ticks, want %d", pid, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestIOAwareAlgorithmsBlockForIO checks that every registered algorithm built on the shared
+// simulate() core frees the CPU for other ready processes while one blocks on I/O, rather than
+// treating IOBursts as a single uninterrupted CPU run.
+func TestIOAwareAlgorithmsBlockForIO(t *testing.T) {
+	processes := []scheduler.Process{
+		{ProcessID: 1, ArrivalTime: 0, BurstDuration: 5, Priority: 1, IOBursts: []int64{2, 3, 3}},
+		{ProcessID: 2, ArrivalTime: 1, BurstDuration: 3, Priority: 1},
+	}
+
+	for _, name := range []string{"fcfs", "rr", "srtf", "sjf", "priority", "mlfq", "lottery", "stride"} {
+		t.Run(name, func(t *testing.T) {
+			algo, ok := scheduler.Get(name)
+			if !ok {
+				t.Fatalf("scheduler.Get(%q) = false, want true", name)
+			}
+
+			res := algo.Schedule(processes)
+
+			var ranWhilePID1Blocked bool
+			for _, ts := range res.Gantt {
+				if ts.PID == 2 && ts.Start >= 2 && ts.Stop <= 5 {
+					ranWhilePID1Blocked = true
+				}
+			}
+			if !ranWhilePID1Blocked {
+				t.Errorf("%s: expected PID 2 to run during PID 1's I/O block [2,5), got Gantt %+v", name, res.Gantt)
+			}
+		})
+	}
+}
+
+func TestNamesMatchGet(t *testing.T) {
+	for _, name := range scheduler.Names() {
+		if _, ok := scheduler.Get(name); !ok {
+			t.Errorf("Names() included %q but Get(%q) failed", name, name)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package scheduler
+
+func init() {
+	Register("sjf", func() Algorithm { return &sjfAlgorithm{} })
+}
+
+type sjfAlgorithm struct{}
+
+func (sjfAlgorithm) Name() string { return "Shortest-job-first" }
+
+// Schedule picks, whenever the CPU is free, the arrived, unfinished process with the shortest
+// burst time. It is non-preemptive: once started, a process keeps the CPU until it finishes or
+// blocks on I/O, and other ready processes run while it is blocked.
+func (sjfAlgorithm) Schedule(processes []Process) Result {
+	return simulate(processes, sjfPick, false, 0)
+}
+
+// sjfPick only chooses among ready processes once the CPU is actually free, picking whichever
+// has the shortest total burst; ties favor whichever arrived earliest.
+func sjfPick(ready []int, processes []Process, remaining []int64, current int, time int64) int {
+	best := ready[0]
+	for _, i := range ready[1:] {
+		if processes[i].BurstDuration < processes[best].BurstDuration ||
+			(processes[i].BurstDuration == processes[best].BurstDuration &&
+				processes[i].ArrivalTime < processes[best].ArrivalTime) {
+			best = i
+		}
+	}
+	return best
+}
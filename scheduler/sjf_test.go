@@ -0,0 +1,31 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/tb7512/4600-Project-1/scheduler"
+)
+
+// TestSJFAndPriorityTerminateWhenFirstProcessArrivesLate guards against a regression where sjf
+// and priority never finished (an infinite loop) whenever the process at slice index 0 arrived
+// after t=0.
+func TestSJFAndPriorityTerminateWhenFirstProcessArrivesLate(t *testing.T) {
+	processes := []scheduler.Process{
+		{ProcessID: 100, ArrivalTime: 3, BurstDuration: 4, Priority: 2},
+		{ProcessID: 200, ArrivalTime: 0, BurstDuration: 2, Priority: 1},
+	}
+
+	for _, name := range []string{"sjf", "priority"} {
+		t.Run(name, func(t *testing.T) {
+			algo, ok := scheduler.Get(name)
+			if !ok {
+				t.Fatalf("scheduler.Get(%q) = false, want true", name)
+			}
+
+			res := algo.Schedule(processes)
+			if len(res.Metrics) != len(processes) {
+				t.Fatalf("len(Metrics) = %d, want %d", len(res.Metrics), len(processes))
+			}
+		})
+	}
+}
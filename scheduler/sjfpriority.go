@@ -0,0 +1,30 @@
+package scheduler
+
+func init() {
+	Register("priority", func() Algorithm { return &sjfPriorityAlgorithm{} })
+}
+
+type sjfPriorityAlgorithm struct{}
+
+func (sjfPriorityAlgorithm) Name() string { return "Priority" }
+
+// Schedule picks, whenever the CPU is free, the arrived, unfinished process with the highest
+// priority (lowest priority number). It is non-preemptive: once started, a process keeps the CPU
+// until it finishes or blocks on I/O, and other ready processes run while it is blocked.
+func (sjfPriorityAlgorithm) Schedule(processes []Process) Result {
+	return simulate(processes, priorityPick, false, 0)
+}
+
+// priorityPick only chooses among ready processes once the CPU is actually free, picking
+// whichever has the lowest priority number; ties favor whichever arrived earliest.
+func priorityPick(ready []int, processes []Process, remaining []int64, current int, time int64) int {
+	best := ready[0]
+	for _, i := range ready[1:] {
+		if processes[i].Priority < processes[best].Priority ||
+			(processes[i].Priority == processes[best].Priority &&
+				processes[i].ArrivalTime < processes[best].ArrivalTime) {
+			best = i
+		}
+	}
+	return best
+}
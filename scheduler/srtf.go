@@ -0,0 +1,27 @@
+package scheduler
+
+func init() {
+	Register("srtf", func() Algorithm { return &srtfAlgorithm{} })
+}
+
+type srtfAlgorithm struct{}
+
+func (srtfAlgorithm) Name() string { return "Shortest-remaining-time-first" }
+
+// Schedule re-evaluates the ready queue every tick and preempts the running process as soon as a
+// process with a shorter remaining burst has arrived.
+func (srtfAlgorithm) Schedule(processes []Process) Result {
+	return simulate(processes, srtfPick, true, 0)
+}
+
+// srtfPick picks the ready process with the shortest remaining time in its current burst; ties
+// favor whichever process is already running so the CPU doesn't preempt for no reason.
+func srtfPick(ready []int, processes []Process, remaining []int64, current int, time int64) int {
+	best := ready[0]
+	for _, i := range ready[1:] {
+		if remaining[i] < remaining[best] || (remaining[i] == remaining[best] && i == current) {
+			best = i
+		}
+	}
+	return best
+}
@@ -0,0 +1,38 @@
+package scheduler
+
+func init() {
+	Register("stride", func() Algorithm { return &strideAlgorithm{} })
+}
+
+const (
+	strideTimeQuantum int64 = 2
+	strideLarge       int64 = 1_000_000_000 // large constant L; stride = strideLarge / tickets
+)
+
+// strideAlgorithm implements stride scheduling, the deterministic counterpart to lottery
+// scheduling: each process advances a pass counter by its stride (inversely proportional to its
+// tickets) every time it runs a quantum, and the scheduler always runs whichever ready process
+// has fallen furthest behind, i.e. has the smallest pass.
+type strideAlgorithm struct {
+	pass []int64
+}
+
+func (a *strideAlgorithm) Name() string { return "Stride" }
+
+func (a *strideAlgorithm) Schedule(processes []Process) Result {
+	a.pass = make([]int64, len(processes))
+	return simulate(processes, a.pick, false, strideTimeQuantum)
+}
+
+// pick runs the ready process with the smallest pass (ties favor the lowest index), then charges
+// it a quantum's worth of stride so its turn comes around again only after the others catch up.
+func (a *strideAlgorithm) pick(ready []int, processes []Process, remaining []int64, current int, time int64) int {
+	best := ready[0]
+	for _, i := range ready[1:] {
+		if a.pass[i] < a.pass[best] || (a.pass[i] == a.pass[best] && i < best) {
+			best = i
+		}
+	}
+	a.pass[best] += strideLarge / ticketsFor(processes[best])
+	return best
+}
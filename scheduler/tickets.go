@@ -0,0 +1,11 @@
+package scheduler
+
+// ticketsFor returns a process's ticket count for the proportional-share algorithms (lottery,
+// stride), reusing the Priority column: a process with no priority set (0) gets the default
+// single ticket so it still competes fairly against ones that specify a count.
+func ticketsFor(p Process) int64 {
+	if p.Priority <= 0 {
+		return 1
+	}
+	return p.Priority
+}
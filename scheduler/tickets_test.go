@@ -0,0 +1,70 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	"github.com/tb7512/4600-Project-1/scheduler"
+)
+
+// ticketedProcesses gives one process far more tickets than the other so that, over a long run,
+// its CPU share should dominate under both proportional-share algorithms.
+func ticketedProcesses() []scheduler.Process {
+	return []scheduler.Process{
+		{ProcessID: 1, ArrivalTime: 0, BurstDuration: 400, Priority: 9},
+		{ProcessID: 2, ArrivalTime: 0, BurstDuration: 400, Priority: 1},
+	}
+}
+
+// completionsByPID maps PID to completion time; both processes here have equal burst and
+// arrival, so a proportional-share scheduler should finish the one with more tickets first.
+func completionsByPID(metrics []scheduler.ProcessMetrics) map[int64]int64 {
+	completions := make(map[int64]int64)
+	for _, m := range metrics {
+		completions[m.ProcessID] = m.Completion
+	}
+	return completions
+}
+
+func TestLotteryFavorsMoreTickets(t *testing.T) {
+	scheduler.LotterySeed = 42
+	algo, ok := scheduler.Get("lottery")
+	if !ok {
+		t.Fatal(`scheduler.Get("lottery") = false, want true`)
+	}
+
+	res := algo.Schedule(ticketedProcesses())
+	completions := completionsByPID(res.Metrics)
+	if completions[1] >= completions[2] {
+		t.Errorf("PID 1 (9 tickets) completed at %d, want before PID 2 (1 ticket)'s %d", completions[1], completions[2])
+	}
+}
+
+func TestStrideFavorsMoreTickets(t *testing.T) {
+	algo, ok := scheduler.Get("stride")
+	if !ok {
+		t.Fatal(`scheduler.Get("stride") = false, want true`)
+	}
+
+	res := algo.Schedule(ticketedProcesses())
+	completions := completionsByPID(res.Metrics)
+	if completions[1] >= completions[2] {
+		t.Errorf("PID 1 (9 tickets) completed at %d, want before PID 2 (1 ticket)'s %d", completions[1], completions[2])
+	}
+}
+
+func TestStrideIsDeterministic(t *testing.T) {
+	algo1, _ := scheduler.Get("stride")
+	algo2, _ := scheduler.Get("stride")
+
+	res1 := algo1.Schedule(ticketedProcesses())
+	res2 := algo2.Schedule(ticketedProcesses())
+
+	if len(res1.Gantt) != len(res2.Gantt) {
+		t.Fatalf("Gantt lengths differ: %d vs %d", len(res1.Gantt), len(res2.Gantt))
+	}
+	for i := range res1.Gantt {
+		if res1.Gantt[i] != res2.Gantt[i] {
+			t.Fatalf("Gantt[%d] differs between runs: %+v vs %+v", i, res1.Gantt[i], res2.Gantt[i])
+		}
+	}
+}